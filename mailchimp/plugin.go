@@ -27,18 +27,53 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			},
 		},
 		TableMap: map[string]*plugin.Table{
-			"mailchimp_authorized_app":   tableMailchimpAuthorizedApp(ctx),
-			"mailchimp_automation_email": tableMailchimpAutomationEmail(ctx),
-			"mailchimp_automation_queue": tableMailchimpAutomationQueue(ctx),
-			"mailchimp_automation":       tableMailchimpAutomation(ctx),
-			"mailchimp_batch_operation":  tableMailchimpBatchOperation(ctx),
-			"mailchimp_campaign_folder":  tableMailchimpCampaignFolder(ctx),
-			"mailchimp_campaign":         tableMailchimpCampaign(ctx),
-			"mailchimp_list":             tableMailchimpList(ctx),
-			"mailchimp_root":             tableMailchimpRoot(ctx),
-			"mailchimp_store":            tableMailchimpStore(ctx),
-			"mailchimp_template_folder":  tableMailchimpTemplateFolder(ctx),
-			"mailchimp_template":         tableMailchimpTemplate(ctx),
+			"mailchimp_authorized_app":                     tableMailchimpAuthorizedApp(ctx),
+			"mailchimp_automation_email":                   tableMailchimpAutomationEmail(ctx),
+			"mailchimp_automation_queue":                   tableMailchimpAutomationQueue(ctx),
+			"mailchimp_automation":                         tableMailchimpAutomation(ctx),
+			"mailchimp_batch_operation":                    tableMailchimpBatchOperation(ctx),
+			"mailchimp_campaign_folder":                    tableMailchimpCampaignFolder(ctx),
+			"mailchimp_campaign_report_abuse":              tableMailchimpCampaignReportAbuse(ctx),
+			"mailchimp_campaign_report_advice":             tableMailchimpCampaignReportAdvice(ctx),
+			"mailchimp_campaign_report_click_detail":       tableMailchimpCampaignReportClickDetail(ctx),
+			"mailchimp_campaign_report_click_member":       tableMailchimpCampaignReportClickMember(ctx),
+			"mailchimp_campaign_report_domain_performance": tableMailchimpCampaignReportDomainPerformance(ctx),
+			"mailchimp_campaign_report_eepurl":             tableMailchimpCampaignReportEepurl(ctx),
+			"mailchimp_campaign_report_email_activity":     tableMailchimpCampaignReportEmailActivity(ctx),
+			"mailchimp_campaign_report_location":           tableMailchimpCampaignReportLocation(ctx),
+			"mailchimp_campaign_report_open_detail":        tableMailchimpCampaignReportOpenDetail(ctx),
+			"mailchimp_campaign_report_product_activity":   tableMailchimpCampaignReportProductActivity(ctx),
+			"mailchimp_campaign_report_sent_to":            tableMailchimpCampaignReportSentTo(ctx),
+			"mailchimp_campaign_report_sub_report":         tableMailchimpCampaignReportSubReport(ctx),
+			"mailchimp_campaign_report_unsubscribe":        tableMailchimpCampaignReportUnsubscribe(ctx),
+			"mailchimp_campaign_report":                    tableMailchimpCampaignReport(ctx),
+			"mailchimp_campaign":                           tableMailchimpCampaign(ctx),
+			"mailchimp_list_abuse_report":                  tableMailchimpListAbuseReport(ctx),
+			"mailchimp_list_activity":                      tableMailchimpListActivity(ctx),
+			"mailchimp_list_client":                        tableMailchimpListClient(ctx),
+			"mailchimp_list_growth_history":                tableMailchimpListGrowthHistory(ctx),
+			"mailchimp_list_interest_category":             tableMailchimpListInterestCategory(ctx),
+			"mailchimp_list_interest":                      tableMailchimpListInterest(ctx),
+			"mailchimp_list_location":                      tableMailchimpListLocation(ctx),
+			"mailchimp_list_member_activity":               tableMailchimpListMemberActivity(ctx),
+			"mailchimp_list_member_event":                  tableMailchimpListMemberEvent(ctx),
+			"mailchimp_list_member_goal":                   tableMailchimpListMemberGoal(ctx),
+			"mailchimp_list_member_note":                   tableMailchimpListMemberNote(ctx),
+			"mailchimp_list_member_tag":                    tableMailchimpListMemberTag(ctx),
+			"mailchimp_list_member":                        tableMailchimpListMember(ctx),
+			"mailchimp_list_merge_field":                   tableMailchimpListMergeField(ctx),
+			"mailchimp_list_segment_member":                tableMailchimpListSegmentMember(ctx),
+			"mailchimp_list_segment":                       tableMailchimpListSegment(ctx),
+			"mailchimp_list_signup_form":                   tableMailchimpListSignupForm(ctx),
+			"mailchimp_list_survey":                        tableMailchimpListSurvey(ctx),
+			"mailchimp_list_tag":                           tableMailchimpListTag(ctx),
+			"mailchimp_list_webhook":                       tableMailchimpListWebhook(ctx),
+			"mailchimp_list":                               tableMailchimpList(ctx),
+			"mailchimp_root":                               tableMailchimpRoot(ctx),
+			"mailchimp_search_member":                      tableMailchimpSearchMember(ctx),
+			"mailchimp_store":                              tableMailchimpStore(ctx),
+			"mailchimp_template_folder":                    tableMailchimpTemplateFolder(ctx),
+			"mailchimp_template":                           tableMailchimpTemplate(ctx),
 		},
 	}
 
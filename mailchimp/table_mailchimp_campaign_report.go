@@ -0,0 +1,473 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReport struct {
+	ID            string `json:"id"`
+	CampaignTitle string `json:"campaign_title"`
+	Type          string `json:"type"`
+	ListID        string `json:"list_id"`
+	ListIsActive  bool   `json:"list_is_active"`
+	ListName      string `json:"list_name"`
+	SubjectLine   string `json:"subject_line"`
+	PreviewText   string `json:"preview_text"`
+	EmailsSent    int    `json:"emails_sent"`
+	AbuseReports  int    `json:"abuse_reports"`
+	Unsubscribed  int    `json:"unsubscribed"`
+	SendTime      string `json:"send_time"`
+	RssLastSend   string `json:"rss_last_send"`
+	Bounces       struct {
+		HardBounces        int `json:"hard_bounces"`
+		SoftBounces        int `json:"soft_bounces"`
+		SyndicationBounces int `json:"syndication_bounces"`
+	} `json:"bounces"`
+	Forwards struct {
+		ForwardsCount int `json:"forwards_count"`
+		ForwardsOpens int `json:"forwards_opens"`
+	} `json:"forwards"`
+	Opens struct {
+		OpensTotal               int     `json:"opens_total"`
+		ProxyExcludedOpens       int     `json:"proxy_excluded_opens"`
+		UniqueOpens              int     `json:"unique_opens"`
+		ProxyExcludedUniqueOpens int     `json:"proxy_excluded_unique_opens"`
+		OpenRate                 float64 `json:"open_rate"`
+		ProxyExcludedOpenRate    float64 `json:"proxy_excluded_open_rate"`
+		LastOpen                 string  `json:"last_open"`
+	} `json:"opens"`
+	Clicks struct {
+		ClicksTotal            int     `json:"clicks_total"`
+		UniqueClicks           int     `json:"unique_clicks"`
+		UniqueSubscriberClicks int     `json:"unique_subscriber_clicks"`
+		ClickRate              float64 `json:"click_rate"`
+		LastClick              string  `json:"last_click"`
+	} `json:"clicks"`
+	Ecommerce struct {
+		TotalOrders  int     `json:"total_orders"`
+		TotalSpent   float64 `json:"total_spent"`
+		TotalRevenue float64 `json:"total_revenue"`
+		CurrencyCode string  `json:"currency_code"`
+	} `json:"ecommerce"`
+	FacebookLikes  map[string]interface{}   `json:"facebook_likes"`
+	IndustryStats  map[string]interface{}   `json:"industry_stats"`
+	ListStats      map[string]interface{}   `json:"list_stats"`
+	AbSplit        map[string]interface{}   `json:"ab_split"`
+	Timewarp       []map[string]interface{} `json:"timewarp"`
+	Timeseries     []map[string]interface{} `json:"timeseries"`
+	ShareReport    map[string]interface{}   `json:"share_report"`
+	DeliveryStatus map[string]interface{}   `json:"delivery_status"`
+}
+
+type listOfCampaignReports struct {
+	Reports    []campaignReport `json:"reports"`
+	TotalItems int              `json:"total_items"`
+}
+
+type campaignReportQueryParams struct {
+	gochimp3.ExtendedQueryParams
+
+	Type           string
+	BeforeSendTime string
+	SinceSendTime  string
+}
+
+func (q *campaignReportQueryParams) Params() map[string]string {
+	m := q.ExtendedQueryParams.Params()
+	m["type"] = q.Type
+	m["before_send_time"] = q.BeforeSendTime
+	m["since_send_time"] = q.SinceSendTime
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report",
+		Description: "Get campaign reports.",
+		List: &plugin.ListConfig{
+			Hydrate: listCampaignReports,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:       "send_time",
+					Operators:  []string{">", ">=", "<", "<=", "="},
+					Require:    plugin.Optional,
+					CacheMatch: "exact",
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id"}),
+			Hydrate:    getCampaignReport,
+		},
+		Columns: commonColumns(campaignReportColumns()),
+	}
+}
+
+func campaignReportColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Description: "A string that uniquely identifies this campaign.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("ID"),
+		},
+		{
+			Name:        "campaign_title",
+			Description: "The title of the campaign.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "type",
+			Description: "The type of campaign (regular, plain-text, ab_split, rss, automation, variate, or auto).",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "list_id",
+			Description: "The unique list id.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("ListID"),
+		},
+		{
+			Name:        "list_name",
+			Description: "The name of the list.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "list_is_active",
+			Description: "The status of the list used, namely if it's deleted or disabled.",
+			Type:        proto.ColumnType_BOOL,
+		},
+		{
+			Name:        "subject_line",
+			Description: "The subject line for the campaign.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "preview_text",
+			Description: "The preview text for the campaign.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "send_time",
+			Description: "The date and time a campaign was sent in ISO 8601 format.",
+			Type:        proto.ColumnType_TIMESTAMP,
+		},
+		{
+			Name:        "rss_last_send",
+			Description: "For RSS campaigns, the date and time of the last send in ISO 8601 format.",
+			Type:        proto.ColumnType_TIMESTAMP,
+		},
+		{
+			Name:        "emails_sent",
+			Description: "The total number of emails sent for this campaign.",
+			Type:        proto.ColumnType_INT,
+		},
+		{
+			Name:        "abuse_reports",
+			Description: "The number of abuse reports generated for this campaign.",
+			Type:        proto.ColumnType_INT,
+		},
+		{
+			Name:        "unsubscribed",
+			Description: "The total number of unsubscribed members for this campaign.",
+			Type:        proto.ColumnType_INT,
+		},
+		{
+			Name:        "hard_bounces",
+			Description: "The total number of hard bounced email addresses.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Bounces.HardBounces"),
+		},
+		{
+			Name:        "soft_bounces",
+			Description: "The total number of soft bounced email addresses.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Bounces.SoftBounces"),
+		},
+		{
+			Name:        "syndication_bounces",
+			Description: "The total number of addresses that were syndication bounces.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Bounces.SyndicationBounces"),
+		},
+		{
+			Name:        "forwards_count",
+			Description: "How many times the campaign has been forwarded.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Forwards.ForwardsCount"),
+		},
+		{
+			Name:        "forwards_opens",
+			Description: "How many times the forwarded campaign has been opened.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Forwards.ForwardsOpens"),
+		},
+		{
+			Name:        "opens_total",
+			Description: "The total number of opens for a campaign.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Opens.OpensTotal"),
+		},
+		{
+			Name:        "unique_opens",
+			Description: "The total number of unique opens.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Opens.UniqueOpens"),
+		},
+		{
+			Name:        "open_rate",
+			Description: "The number of unique opens divided by the total number of successful deliveries.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Opens.OpenRate"),
+		},
+		{
+			Name:        "proxy_excluded_opens",
+			Description: "The total number of opens, excluding Apple Mail Privacy Protection (MPP) opens.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Opens.ProxyExcludedOpens"),
+		},
+		{
+			Name:        "proxy_excluded_unique_opens",
+			Description: "The number of unique opens, excluding Apple Mail Privacy Protection (MPP) opens.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Opens.ProxyExcludedUniqueOpens"),
+		},
+		{
+			Name:        "proxy_excluded_open_rate",
+			Description: "The unique open rate, excluding Apple Mail Privacy Protection (MPP) opens.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Opens.ProxyExcludedOpenRate"),
+		},
+		{
+			Name:        "last_open",
+			Description: "The date and time of the last recorded open in ISO 8601 format.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("Opens.LastOpen").NullIfZero(),
+		},
+		{
+			Name:        "clicks_total",
+			Description: "The total number of clicks for the campaign.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Clicks.ClicksTotal"),
+		},
+		{
+			Name:        "unique_clicks",
+			Description: "The total number of unique clicks for links across a campaign.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Clicks.UniqueClicks"),
+		},
+		{
+			Name:        "unique_subscriber_clicks",
+			Description: "The total number of subscribers who clicked on a campaign.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Clicks.UniqueSubscriberClicks"),
+		},
+		{
+			Name:        "click_rate",
+			Description: "The number of unique clicks divided by the total number of successful deliveries.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Clicks.ClickRate"),
+		},
+		{
+			Name:        "last_click",
+			Description: "The date and time of the last recorded click for the campaign in ISO 8601 format.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("Clicks.LastClick").NullIfZero(),
+		},
+		{
+			Name:        "ecommerce_total_orders",
+			Description: "The total orders for a campaign.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("Ecommerce.TotalOrders"),
+		},
+		{
+			Name:        "ecommerce_total_spent",
+			Description: "The total spent for a campaign. Calculated as the sum of all order totals with no deductions.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Ecommerce.TotalSpent"),
+		},
+		{
+			Name:        "ecommerce_total_revenue",
+			Description: "The total revenue for a campaign. Calculated as the sum of all order totals minus shipping and tax totals.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Ecommerce.TotalRevenue"),
+		},
+		{
+			Name:        "ecommerce_currency_code",
+			Description: "The three-letter ISO 4217 currency code of the ecommerce revenue.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Ecommerce.CurrencyCode").NullIfZero(),
+		},
+
+		// JSON fields
+
+		{
+			Name:        "ab_split",
+			Description: "General stats about different groups of an A/B Split campaign. Does not return information about Multivariate Campaigns.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "delivery_status",
+			Description: "Updates on campaigns in the process of sending.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "facebook_likes",
+			Description: "Campaign engagement on Facebook.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "industry_stats",
+			Description: "The average campaign statistics for your industry.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "list_stats",
+			Description: "The average campaign statistics for your list. This won't be present if the campaign hasn't been sent.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "share_report",
+			Description: "The url and password for the VIP report.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "timeseries",
+			Description: "An hourly breakdown of the performance of the campaign over the first 24 hours.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "timewarp",
+			Description: "An hourly breakdown of sends, opens, and clicks if a campaign is sent using timewarp.",
+			Type:        proto.ColumnType_JSON,
+		},
+
+		// Standard Steampipe columns
+		{
+			Name:        "title",
+			Description: "The title of the resource.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("CampaignTitle"),
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report.listCampaignReports", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := campaignReportQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.EqualsQuals["type"] != nil {
+		params.Type = d.EqualsQualString("type")
+	}
+	if d.Quals["send_time"] != nil {
+		for _, q := range d.Quals["send_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			timestampAdd := q.Value.GetTimestampValue().AsTime().Add(time.Second).Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				params.SinceSendTime = timestamp
+			case "<":
+				params.BeforeSendTime = timestamp
+			case "<=":
+				params.BeforeSendTime = timestampAdd
+			case "=":
+				params.SinceSendTime = timestamp
+				params.BeforeSendTime = timestampAdd
+			}
+		}
+	}
+
+	last := 0
+
+	for {
+		reports := new(listOfCampaignReports)
+		err := client.Request("GET", "/reports", &params, nil, reports)
+		if err != nil {
+			logger.Error("mailchimp_campaign_report.listCampaignReports", "api_error", err)
+			return nil, err
+		}
+
+		for _, report := range reports.Reports {
+			d.StreamListItem(ctx, &report)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(reports.Reports)
+		if last >= reports.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCampaignReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQualString("id")
+
+	// Campaign id should not be empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report.getCampaignReport", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s", id)
+	report := new(campaignReport)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, report)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report.getCampaignReport", "api_error", err)
+		return nil, err
+	}
+
+	return report, nil
+}
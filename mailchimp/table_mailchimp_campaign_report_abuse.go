@@ -0,0 +1,184 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportAbuse struct {
+	ID           int                    `json:"id"`
+	CampaignID   string                 `json:"campaign_id"`
+	ListID       string                 `json:"list_id"`
+	EmailID      string                 `json:"email_id"`
+	EmailAddress string                 `json:"email_address"`
+	MergeFields  map[string]interface{} `json:"merge_fields"`
+	VIP          bool                   `json:"vip"`
+	Date         string                 `json:"date"`
+}
+
+type listOfCampaignReportAbuses struct {
+	AbuseReports []campaignReportAbuse `json:"abuse_reports"`
+	CampaignID   string                `json:"campaign_id"`
+	TotalItems   int                   `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportAbuse(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_abuse",
+		Description: "Get a list of abuse complaints for a specific campaign.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportAbuses,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "campaign_id"}),
+			Hydrate:    getCampaignReportAbuse,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The id for the abuse report.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "email_id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "date",
+				Description: "Date for the abuse report.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportAbuses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_abuse.listCampaignReportAbuses", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/abuse-reports", campaign.ID)
+	abuseReports := new(listOfCampaignReportAbuses)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, abuseReports)
+	if err != nil {
+		// The campaign has no report
+		if isNotFoundError([]string{"404"})(err) {
+			return nil, nil
+		}
+		logger.Error("mailchimp_campaign_report_abuse.listCampaignReportAbuses", "api_error", err)
+		return nil, err
+	}
+
+	for _, abuseReport := range abuseReports.AbuseReports {
+		d.StreamListItem(ctx, &abuseReport)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCampaignReportAbuse(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQuals["id"].GetInt64Value()
+	campaignId := d.EqualsQualString("campaign_id")
+
+	// Abuse report id and campaign id should not be empty
+	if id == 0 || campaignId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_abuse.getCampaignReportAbuse", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/abuse-reports/%d", campaignId, id)
+	abuseReport := new(campaignReportAbuse)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, abuseReport)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_abuse.getCampaignReportAbuse", "api_error", err)
+		return nil, err
+	}
+
+	return abuseReport, nil
+}
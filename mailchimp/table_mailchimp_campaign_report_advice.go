@@ -0,0 +1,104 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportAdvice struct {
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+	CampaignID string
+}
+
+type listOfCampaignReportAdvice struct {
+	Advice     []campaignReportAdvice `json:"advice"`
+	CampaignID string                 `json:"campaign_id"`
+	TotalItems int                    `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportAdvice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_advice",
+		Description: "Get feedback based on a campaign's statistics.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportAdvice,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of advice. Possible values: 'negative', 'positive', 'neutral'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The advice message.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportAdvice(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_advice.listCampaignReportAdvice", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/advice", campaign.ID)
+	advice := new(listOfCampaignReportAdvice)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, advice)
+	if err != nil {
+		// The campaign has no report
+		if isNotFoundError([]string{"404"})(err) {
+			return nil, nil
+		}
+		logger.Error("mailchimp_campaign_report_advice.listCampaignReportAdvice", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range advice.Advice {
+		item.CampaignID = campaign.ID
+		d.StreamListItem(ctx, &item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,210 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportClickDetail struct {
+	ID                    string                 `json:"id"`
+	URL                   string                 `json:"url"`
+	TotalClicks           int                    `json:"total_clicks"`
+	ClickPercentage       float64                `json:"click_percentage"`
+	UniqueClicks          int                    `json:"unique_clicks"`
+	UniqueClickPercentage float64                `json:"unique_click_percentage"`
+	LastClick             string                 `json:"last_click"`
+	AbSplit               map[string]interface{} `json:"ab_split"`
+	CampaignID            string                 `json:"campaign_id"`
+}
+
+type listOfCampaignReportClickDetails struct {
+	CampaignID  string                      `json:"campaign_id"`
+	URLsClicked []campaignReportClickDetail `json:"urls_clicked"`
+	TotalItems  int                         `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportClickDetail(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_click_detail",
+		Description: "Get information about clicks on specific links in your Mailchimp campaigns.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportClickDetails,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "campaign_id"}),
+			Hydrate:    getCampaignReportClickDetail,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique id for the link.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "url",
+				Description: "The URL for the link in the campaign.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "total_clicks",
+				Description: "The number of total clicks for a link.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "click_percentage",
+				Description: "The percentage of total clicks for a link.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unique_clicks",
+				Description: "The number of unique clicks for a link.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unique_click_percentage",
+				Description: "The percentage of unique clicks for a link.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "last_click",
+				Description: "The date and time for the last recorded click for the link in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// JSON fields
+
+			{
+				Name:        "ab_split",
+				Description: "A breakdown of clicks by the groups of an A/B Split campaign.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportClickDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_click_detail.listCampaignReportClickDetails", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/click-details", campaign.ID)
+	last := 0
+
+	for {
+		clickDetails := new(listOfCampaignReportClickDetails)
+		err := client.Request("GET", endpoint, &params, nil, clickDetails)
+		if err != nil {
+			// The campaign has no report
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_campaign_report_click_detail.listCampaignReportClickDetails", "api_error", err)
+			return nil, err
+		}
+
+		for _, clickDetail := range clickDetails.URLsClicked {
+			d.StreamListItem(ctx, &clickDetail)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(clickDetails.URLsClicked)
+		if last >= clickDetails.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCampaignReportClickDetail(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQualString("id")
+	campaignId := d.EqualsQualString("campaign_id")
+
+	// Link id and campaign id should not be empty
+	if id == "" || campaignId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_click_detail.getCampaignReportClickDetail", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/click-details/%s", campaignId, id)
+	clickDetail := new(campaignReportClickDetail)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, clickDetail)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_click_detail.getCampaignReportClickDetail", "api_error", err)
+		return nil, err
+	}
+
+	return clickDetail, nil
+}
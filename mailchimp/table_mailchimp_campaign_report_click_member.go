@@ -0,0 +1,220 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportClickMember struct {
+	EmailID       string                 `json:"email_id"`
+	EmailAddress  string                 `json:"email_address"`
+	MergeFields   map[string]interface{} `json:"merge_fields"`
+	VIP           bool                   `json:"vip"`
+	Clicks        int                    `json:"clicks"`
+	CampaignID    string                 `json:"campaign_id"`
+	URLID         string                 `json:"url_id"`
+	ListID        string                 `json:"list_id"`
+	ListIsActive  bool                   `json:"list_is_active"`
+	ContactStatus string                 `json:"contact_status"`
+}
+
+type listOfCampaignReportClickMembers struct {
+	CampaignID string                      `json:"campaign_id"`
+	Members    []campaignReportClickMember `json:"members"`
+	TotalItems int                         `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportClickMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_click_member",
+		Description: "Get information about list members who clicked on specific links in your Mailchimp campaigns.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportClickMembers,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id", "url_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "email_id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "url_id",
+				Description: "The id for the tracked URL in the campaign.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URLID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "list_is_active",
+				Description: "The status of the list used, namely if it's deleted or disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "clicks",
+				Description: "The total number of times the subscriber clicked on the link.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "contact_status",
+				Description: "The status of the member, namely if they are subscribed, unsubscribed, deleted, non-subscribed, transactional, pending, or need reconfirmation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportClickMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_click_member.listCampaignReportClickMembers", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	var urlIds []string
+	if d.EqualsQuals["url_id"] != nil {
+		urlIds = append(urlIds, d.EqualsQualString("url_id"))
+	} else {
+		params := gochimp3.ExtendedQueryParams{
+			BasicQueryParams: gochimp3.BasicQueryParams{
+				Fields: []string{"urls_clicked.id", "total_items"},
+			},
+			Count:  1000,
+			Offset: 0,
+		}
+		endpoint := fmt.Sprintf("/reports/%s/click-details", campaign.ID)
+
+		for {
+			clickDetails := new(listOfCampaignReportClickDetails)
+			err := client.Request("GET", endpoint, &params, nil, clickDetails)
+			if err != nil {
+				// The campaign has no report
+				if isNotFoundError([]string{"404"})(err) {
+					return nil, nil
+				}
+				logger.Error("mailchimp_campaign_report_click_member.listCampaignReportClickMembers", "api_error", err)
+				return nil, err
+			}
+
+			for _, clickDetail := range clickDetails.URLsClicked {
+				urlIds = append(urlIds, clickDetail.ID)
+			}
+
+			params.Offset = params.Offset + len(clickDetails.URLsClicked)
+			if len(clickDetails.URLsClicked) == 0 || params.Offset >= clickDetails.TotalItems {
+				break
+			}
+		}
+	}
+
+	for _, urlId := range urlIds {
+		params := gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		}
+		endpoint := fmt.Sprintf("/reports/%s/click-details/%s/members", campaign.ID, urlId)
+		last := 0
+
+		for {
+			members := new(listOfCampaignReportClickMembers)
+			err := client.Request("GET", endpoint, &params, nil, members)
+			if err != nil {
+				// The campaign has no report, or the link only exists in another campaign
+				if isNotFoundError([]string{"404"})(err) {
+					return nil, nil
+				}
+				logger.Error("mailchimp_campaign_report_click_member.listCampaignReportClickMembers", "api_error", err)
+				return nil, err
+			}
+
+			for _, member := range members.Members {
+				d.StreamListItem(ctx, &member)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			last = params.Offset + len(members.Members)
+			if len(members.Members) == 0 || last >= members.TotalItems {
+				break
+			}
+			params.Offset = last
+		}
+	}
+
+	return nil, nil
+}
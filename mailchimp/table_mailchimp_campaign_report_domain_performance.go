@@ -0,0 +1,173 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportDomainPerformance struct {
+	Domain     string  `json:"domain"`
+	EmailsSent int     `json:"emails_sent"`
+	Bounces    int     `json:"bounces"`
+	Opens      int     `json:"opens"`
+	Clicks     int     `json:"clicks"`
+	Unsubs     int     `json:"unsubs"`
+	Delivered  int     `json:"delivered"`
+	EmailsPct  float64 `json:"emails_pct"`
+	BouncesPct float64 `json:"bounces_pct"`
+	OpensPct   float64 `json:"opens_pct"`
+	ClicksPct  float64 `json:"clicks_pct"`
+	UnsubsPct  float64 `json:"unsubs_pct"`
+	CampaignID string
+}
+
+type listOfCampaignReportDomainPerformance struct {
+	Domains    []campaignReportDomainPerformance `json:"domains"`
+	TotalSent  int                               `json:"total_sent"`
+	CampaignID string                            `json:"campaign_id"`
+	TotalItems int                               `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportDomainPerformance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_domain_performance",
+		Description: "Get statistics for the top-performing email domains in a campaign.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportDomainPerformance,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "domain",
+				Description: "The name of the domain (gmail.com, hotmail.com, yahoo.com).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "emails_sent",
+				Description: "The number of emails sent to that specific domain.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "delivered",
+				Description: "The number of successful deliveries for that domain.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "bounces",
+				Description: "The number of bounces at a domain.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "opens",
+				Description: "The number of opens for a domain.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "clicks",
+				Description: "The number of clicks for a domain.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unsubs",
+				Description: "The total number of unsubscribes for a domain.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "emails_pct",
+				Description: "The percentage of total emails that went to this domain.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "bounces_pct",
+				Description: "The percentage of total bounces from this domain.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "opens_pct",
+				Description: "The percentage of total opens from this domain.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "clicks_pct",
+				Description: "The percentage of total clicks from this domain.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unsubs_pct",
+				Description: "The percentage of total unsubscribes from this domain.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Domain"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportDomainPerformance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_domain_performance.listCampaignReportDomainPerformance", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/domain-performance", campaign.ID)
+	domains := new(listOfCampaignReportDomainPerformance)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, domains)
+	if err != nil {
+		// The campaign has no report
+		if isNotFoundError([]string{"404"})(err) {
+			return nil, nil
+		}
+		logger.Error("mailchimp_campaign_report_domain_performance.listCampaignReportDomainPerformance", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range domains.Domains {
+		domain.CampaignID = campaign.ID
+		d.StreamListItem(ctx, &domain)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
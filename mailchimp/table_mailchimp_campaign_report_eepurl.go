@@ -0,0 +1,160 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportEepurl struct {
+	Twitter struct {
+		Tweets     int                      `json:"tweets"`
+		FirstTweet string                   `json:"first_tweet"`
+		LastTweet  string                   `json:"last_tweet"`
+		Retweets   int                      `json:"retweets"`
+		Statuses   []map[string]interface{} `json:"statuses"`
+	} `json:"twitter"`
+	Clicks struct {
+		Clicks     int                      `json:"clicks"`
+		FirstClick string                   `json:"first_click"`
+		LastClick  string                   `json:"last_click"`
+		Locations  []map[string]interface{} `json:"locations"`
+	} `json:"clicks"`
+	Referrers  []map[string]interface{} `json:"referrers"`
+	Eepurl     string                   `json:"eepurl"`
+	CampaignID string                   `json:"campaign_id"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportEepurl(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_eepurl",
+		Description: "Get a summary of social activity for the campaign, tracked by EepURL.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportEepurls,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "eepurl",
+				Description: "A shortened link used for tracking the campaign on social media.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "clicks",
+				Description: "The total number of clicks to the campaign's EepURL.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Clicks.Clicks"),
+			},
+			{
+				Name:        "first_click",
+				Description: "The date and time of the first click to the campaign's EepURL in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Clicks.FirstClick").NullIfZero(),
+			},
+			{
+				Name:        "last_click",
+				Description: "The date and time of the last click to the campaign's EepURL in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Clicks.LastClick").NullIfZero(),
+			},
+			{
+				Name:        "tweets",
+				Description: "The number of tweets including the campaign's EepURL.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Twitter.Tweets"),
+			},
+			{
+				Name:        "retweets",
+				Description: "The number of retweets that include the campaign's EepURL.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Twitter.Retweets"),
+			},
+			{
+				Name:        "first_tweet",
+				Description: "The date and time of the first recorded tweet with the campaign's EepURL.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Twitter.FirstTweet").NullIfZero(),
+			},
+			{
+				Name:        "last_tweet",
+				Description: "The date and time of the last recorded tweet with the campaign's EepURL.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Twitter.LastTweet").NullIfZero(),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "click_locations",
+				Description: "The locations of the clicks to the campaign's EepURL.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Clicks.Locations"),
+			},
+			{
+				Name:        "referrers",
+				Description: "A summary of the top referrers to the campaign's EepURL, with their clicks and the first and last click times.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "twitter_statuses",
+				Description: "A summary of the tweets that included the campaign's EepURL.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Twitter.Statuses"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportEepurls(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_eepurl.listCampaignReportEepurls", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/eepurl", campaign.ID)
+	eepurl := new(campaignReportEepurl)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, eepurl)
+	if err != nil {
+		// The campaign has no report
+		if isNotFoundError([]string{"404"})(err) {
+			return nil, nil
+		}
+		logger.Error("mailchimp_campaign_report_eepurl.listCampaignReportEepurls", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, eepurl)
+
+	return nil, nil
+}
@@ -0,0 +1,259 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportEmailActivity struct {
+	CampaignID   string
+	ListID       string
+	ListIsActive bool
+	EmailID      string
+	EmailAddress string
+	Action       string `json:"action"`
+	Type         string `json:"type"`
+	Timestamp    string `json:"timestamp"`
+	URL          string `json:"url"`
+	IP           string `json:"ip"`
+}
+
+type campaignReportEmail struct {
+	CampaignID   string                        `json:"campaign_id"`
+	ListID       string                        `json:"list_id"`
+	ListIsActive bool                          `json:"list_is_active"`
+	EmailID      string                        `json:"email_id"`
+	EmailAddress string                        `json:"email_address"`
+	Activity     []campaignReportEmailActivity `json:"activity"`
+}
+
+type listOfCampaignReportEmails struct {
+	CampaignID string                `json:"campaign_id"`
+	Emails     []campaignReportEmail `json:"emails"`
+	TotalItems int                   `json:"total_items"`
+}
+
+type campaignReportEmailActivityQueryParams struct {
+	gochimp3.ExtendedQueryParams
+
+	Since string
+}
+
+func (q *campaignReportEmailActivityQueryParams) Params() map[string]string {
+	m := q.ExtendedQueryParams.Params()
+	m["since"] = q.Since
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportEmailActivity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_email_activity",
+		Description: "Get a list of member's subscriber activity in a specific campaign.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportEmailActivities,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "campaign_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "email_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:       "timestamp",
+					Operators:  []string{">", ">=", "="},
+					Require:    plugin.Optional,
+					CacheMatch: "exact",
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "email_id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action",
+				Description: "One of the following actions: 'open', 'click', or 'bounce'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "If the action is a 'bounce', the type of bounce received: 'hard', 'soft'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The date and time recorded for the action in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "url",
+				Description: "If the action is a 'click', the URL on which the member clicked.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+			{
+				Name:        "ip",
+				Description: "The IP address recorded for the action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IP"),
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "list_is_active",
+				Description: "The status of the list used, namely if it's deleted or disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportEmailActivities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_email_activity.listCampaignReportEmailActivities", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := campaignReportEmailActivityQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			params.Since = q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+		}
+	}
+
+	// Get the activity of a single recipient
+	if d.EqualsQuals["email_id"] != nil {
+		endpoint := fmt.Sprintf("/reports/%s/email-activity/%s", campaign.ID, d.EqualsQualString("email_id"))
+		email := new(campaignReportEmail)
+
+		err := client.Request("GET", endpoint, &params, nil, email)
+		if err != nil {
+			// The member was not a recipient of this campaign
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_campaign_report_email_activity.listCampaignReportEmailActivities", "api_error", err)
+			return nil, err
+		}
+
+		streamCampaignReportEmailActivities(ctx, d, email)
+		return nil, nil
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/email-activity", campaign.ID)
+	last := 0
+
+	for {
+		emails := new(listOfCampaignReportEmails)
+		err := client.Request("GET", endpoint, &params, nil, emails)
+		if err != nil {
+			// The campaign has no report
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_campaign_report_email_activity.listCampaignReportEmailActivities", "api_error", err)
+			return nil, err
+		}
+
+		for _, email := range emails.Emails {
+			if !streamCampaignReportEmailActivities(ctx, d, &email) {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(emails.Emails)
+		if last >= emails.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+// streamCampaignReportEmailActivities streams one row per activity of the
+// email and reports whether more rows are wanted.
+func streamCampaignReportEmailActivities(ctx context.Context, d *plugin.QueryData, email *campaignReportEmail) bool {
+	for _, activity := range email.Activity {
+		activity.CampaignID = email.CampaignID
+		activity.ListID = email.ListID
+		activity.ListIsActive = email.ListIsActive
+		activity.EmailID = email.EmailID
+		activity.EmailAddress = email.EmailAddress
+		d.StreamListItem(ctx, &activity)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
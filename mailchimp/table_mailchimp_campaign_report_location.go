@@ -0,0 +1,144 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportLocation struct {
+	CountryCode        string `json:"country_code"`
+	Region             string `json:"region"`
+	RegionName         string `json:"region_name"`
+	Opens              int    `json:"opens"`
+	ProxyExcludedOpens int    `json:"proxy_excluded_opens"`
+	CampaignID         string
+}
+
+type listOfCampaignReportLocations struct {
+	Locations  []campaignReportLocation `json:"locations"`
+	CampaignID string                   `json:"campaign_id"`
+	TotalItems int                      `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportLocation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_location",
+		Description: "Get top open locations for a specific campaign.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportLocations,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "country_code",
+				Description: "The ISO 3166 2 digit country code.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "An abbreviation for the region.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region_name",
+				Description: "The name of the region.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "opens",
+				Description: "The number of unique campaign opens for a region.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "proxy_excluded_opens",
+				Description: "The number of unique campaign opens for a region, excluding Apple Mail Privacy Protection (MPP) opens.",
+				Type:        proto.ColumnType_INT,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportLocations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_location.listCampaignReportLocations", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/locations", campaign.ID)
+	last := 0
+
+	for {
+		locations := new(listOfCampaignReportLocations)
+		err := client.Request("GET", endpoint, &params, nil, locations)
+		if err != nil {
+			// The campaign has no report
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_campaign_report_location.listCampaignReportLocations", "api_error", err)
+			return nil, err
+		}
+
+		for _, location := range locations.Locations {
+			location.CampaignID = campaign.ID
+			d.StreamListItem(ctx, &location)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(locations.Locations)
+		if last >= locations.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportOpen struct {
+	Timestamp   string `json:"timestamp"`
+	IsProxyOpen bool   `json:"is_proxy_open"`
+}
+
+type campaignReportOpenDetail struct {
+	CampaignID              string                 `json:"campaign_id"`
+	ListID                  string                 `json:"list_id"`
+	ListIsActive            bool                   `json:"list_is_active"`
+	ContactStatus           string                 `json:"contact_status"`
+	EmailID                 string                 `json:"email_id"`
+	EmailAddress            string                 `json:"email_address"`
+	MergeFields             map[string]interface{} `json:"merge_fields"`
+	VIP                     bool                   `json:"vip"`
+	OpensCount              int                    `json:"opens_count"`
+	ProxyExcludedOpensCount int                    `json:"proxy_excluded_opens_count"`
+	Opens                   []campaignReportOpen   `json:"opens"`
+	Timestamp               string
+}
+
+type listOfCampaignReportOpenDetails struct {
+	CampaignID              string                     `json:"campaign_id"`
+	Members                 []campaignReportOpenDetail `json:"members"`
+	TotalOpens              int                        `json:"total_opens"`
+	TotalProxyExcludedOpens int                        `json:"total_proxy_excluded_opens"`
+	TotalItems              int                        `json:"total_items"`
+}
+
+type campaignReportOpenDetailQueryParams struct {
+	gochimp3.ExtendedQueryParams
+
+	Since         string
+	ProxyExcluded bool
+}
+
+func (q *campaignReportOpenDetailQueryParams) Params() map[string]string {
+	m := q.ExtendedQueryParams.Params()
+	m["since"] = q.Since
+	if q.ProxyExcluded {
+		m["proxy_excluded"] = "true"
+	}
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportOpenDetail(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_open_detail",
+		Description: "Get detailed information about any campaign emails that were opened by a list member.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportOpenDetails,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "campaign_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "proxy_excluded",
+					Require: plugin.Optional,
+				},
+				{
+					Name:       "timestamp",
+					Operators:  []string{">", ">="},
+					Require:    plugin.Optional,
+					CacheMatch: "exact",
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "email_id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "list_is_active",
+				Description: "The status of the list used, namely if it's deleted or disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "contact_status",
+				Description: "The status of the member, namely if they are subscribed, unsubscribed, deleted, non-subscribed, transactional, pending, or need reconfirmation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "opens_count",
+				Description: "The total number of times the subscriber opened the campaign.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "proxy_excluded_opens_count",
+				Description: "The total number of times the subscriber opened the campaign, excluding Apple Mail Privacy Protection (MPP) opens.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "proxy_excluded",
+				Description: "Whether Apple Mail Privacy Protection (MPP) opens were excluded from the results.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("proxy_excluded"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The date and time of the most recent open returned for the member in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "opens",
+				Description: "An array of timestamps for each time a list member opened the campaign, including whether the open was a proxy open.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportOpenDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_open_detail.listCampaignReportOpenDetails", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := campaignReportOpenDetailQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			params.Since = q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+		}
+	}
+	if d.EqualsQuals["proxy_excluded"] != nil {
+		params.ProxyExcluded = d.EqualsQuals["proxy_excluded"].GetBoolValue()
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/open-details", campaign.ID)
+	last := 0
+
+	for {
+		openDetails := new(listOfCampaignReportOpenDetails)
+		err := client.Request("GET", endpoint, &params, nil, openDetails)
+		if err != nil {
+			// The campaign has no report
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_campaign_report_open_detail.listCampaignReportOpenDetails", "api_error", err)
+			return nil, err
+		}
+
+		for _, openDetail := range openDetails.Members {
+			for _, open := range openDetail.Opens {
+				if open.Timestamp > openDetail.Timestamp {
+					openDetail.Timestamp = open.Timestamp
+				}
+			}
+			d.StreamListItem(ctx, &openDetail)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(openDetails.Members)
+		if last >= openDetails.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
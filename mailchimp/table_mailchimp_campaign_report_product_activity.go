@@ -0,0 +1,164 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportProductActivity struct {
+	Title                   string  `json:"title"`
+	SKU                     string  `json:"sku"`
+	ImageURL                string  `json:"image_url"`
+	TotalRevenue            float64 `json:"total_revenue"`
+	TotalPurchased          int     `json:"total_purchased"`
+	CurrencyCode            string  `json:"currency_code"`
+	RecommendationTotal     int     `json:"recommendation_total"`
+	RecommendationPurchased int     `json:"recommendation_purchased"`
+	CampaignID              string
+}
+
+type listOfCampaignReportProductActivities struct {
+	Products   []campaignReportProductActivity `json:"products"`
+	CampaignID string                          `json:"campaign_id"`
+	TotalItems int                             `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportProductActivity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_product_activity",
+		Description: "Get breakdown of product activity for a campaign.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportProductActivities,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "title",
+				Description: "The name of the product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku",
+				Description: "The SKU for the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SKU"),
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "image_url",
+				Description: "The image for the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImageURL"),
+			},
+			{
+				Name:        "total_revenue",
+				Description: "The total revenue for the product.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "total_purchased",
+				Description: "The total number of times the product was purchased.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The three-letter ISO 4217 currency code of the revenue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation_total",
+				Description: "The total number of times the product was recommended.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "recommendation_purchased",
+				Description: "The total number of times the product was purchased as a result of a recommendation.",
+				Type:        proto.ColumnType_INT,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportProductActivities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_product_activity.listCampaignReportProductActivities", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/ecommerce-product-activity", campaign.ID)
+	last := 0
+
+	for {
+		products := new(listOfCampaignReportProductActivities)
+		err := client.Request("GET", endpoint, &params, nil, products)
+		if err != nil {
+			// The campaign has no report
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_campaign_report_product_activity.listCampaignReportProductActivities", "api_error", err)
+			return nil, err
+		}
+
+		for _, product := range products.Products {
+			product.CampaignID = campaign.ID
+			d.StreamListItem(ctx, &product)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(products.Products)
+		if last >= products.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
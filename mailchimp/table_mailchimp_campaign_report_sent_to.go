@@ -0,0 +1,194 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignReportSentTo struct {
+	EmailID      string                 `json:"email_id"`
+	EmailAddress string                 `json:"email_address"`
+	MergeFields  map[string]interface{} `json:"merge_fields"`
+	VIP          bool                   `json:"vip"`
+	Status       string                 `json:"status"`
+	OpenCount    int                    `json:"open_count"`
+	LastOpen     string                 `json:"last_open"`
+	AbsplitGroup string                 `json:"absplit_group"`
+	GmtOffset    int                    `json:"gmt_offset"`
+	CampaignID   string                 `json:"campaign_id"`
+	ListID       string                 `json:"list_id"`
+	ListIsActive bool                   `json:"list_is_active"`
+}
+
+type listOfCampaignReportSentTo struct {
+	CampaignID string                 `json:"campaign_id"`
+	SentTo     []campaignReportSentTo `json:"sent_to"`
+	TotalItems int                    `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportSentTo(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_sent_to",
+		Description: "Get information about campaign recipients.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportSentTo,
+			KeyColumns:    plugin.OptionalColumns([]string{"campaign_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "email_id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the member (sent, hard for hard bounce, or soft for soft bounce).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "open_count",
+				Description: "The number of times a campaign was opened by this member.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_open",
+				Description: "The date and time of the last open for this member in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "absplit_group",
+				Description: "For A/B Split Campaigns, the group the member was apart of (a, b, or winner).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gmt_offset",
+				Description: "For campaigns sent with timewarp, the time zone group the member is apart of.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GmtOffset"),
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "list_is_active",
+				Description: "The status of the list used, namely if it's deleted or disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportSentTo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["campaign_id"] != nil && d.EqualsQualString("campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Reports are only available for campaigns that have been sent
+	if !campaignWasSent(campaign) {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_sent_to.listCampaignReportSentTo", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/sent-to", campaign.ID)
+	last := 0
+
+	for {
+		recipients := new(listOfCampaignReportSentTo)
+		err := client.Request("GET", endpoint, &params, nil, recipients)
+		if err != nil {
+			// The campaign has no report
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_campaign_report_sent_to.listCampaignReportSentTo", "api_error", err)
+			return nil, err
+		}
+
+		for _, recipient := range recipients.SentTo {
+			d.StreamListItem(ctx, &recipient)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(recipients.SentTo)
+		if last >= recipients.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
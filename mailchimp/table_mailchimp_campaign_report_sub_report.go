@@ -0,0 +1,94 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type campaignSubReport struct {
+	campaignReport
+	ParentCampaignID string
+}
+
+type listOfCampaignSubReports struct {
+	Reports          []campaignReport `json:"reports"`
+	ParentCampaignID string           `json:"parent_campaign_id"`
+	TotalItems       int              `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpCampaignReportSubReport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_campaign_report_sub_report",
+		Description: "Get a list of reports with child campaigns for a specific parent campaign.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCampaigns,
+			Hydrate:       listCampaignReportSubReports,
+			KeyColumns:    plugin.OptionalColumns([]string{"parent_campaign_id"}),
+		},
+		Columns: commonColumns(append([]*plugin.Column{
+			{
+				Name:        "parent_campaign_id",
+				Description: "The id of the parent campaign.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ParentCampaignID"),
+			},
+		}, campaignReportColumns()...)),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCampaignReportSubReports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	campaign := h.Item.(*gochimp3.CampaignResponse)
+
+	if d.EqualsQuals["parent_campaign_id"] != nil && d.EqualsQualString("parent_campaign_id") != campaign.ID {
+		return nil, nil
+	}
+
+	// Only RSS and A/B testing campaigns have child campaigns, so skip the others
+	switch campaign.Type {
+	case "rss", "variate", "absplit":
+	default:
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_campaign_report_sub_report.listCampaignReportSubReports", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/reports/%s/sub-reports", campaign.ID)
+	subReports := new(listOfCampaignSubReports)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, subReports)
+	if err != nil {
+		// Campaigns without child campaigns may not have a report
+		if isNotFoundError([]string{"404"})(err) {
+			return nil, nil
+		}
+		logger.Error("mailchimp_campaign_report_sub_report.listCampaignReportSubReports", "api_error", err)
+		return nil, err
+	}
+
+	for _, report := range subReports.Reports {
+		d.StreamListItem(ctx, &campaignSubReport{campaignReport: report, ParentCampaignID: campaign.ID})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,197 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listAbuseReport struct {
+	ID           int                    `json:"id"`
+	CampaignID   string                 `json:"campaign_id"`
+	ListID       string                 `json:"list_id"`
+	EmailID      string                 `json:"email_id"`
+	EmailAddress string                 `json:"email_address"`
+	MergeFields  map[string]interface{} `json:"merge_fields"`
+	VIP          bool                   `json:"vip"`
+	Date         string                 `json:"date"`
+}
+
+type listOfListAbuseReports struct {
+	ListID       string            `json:"list_id"`
+	AbuseReports []listAbuseReport `json:"abuse_reports"`
+	TotalItems   int               `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListAbuseReport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_abuse_report",
+		Description: "Get all abuse reports for a specific list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListAbuseReports,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "list_id"}),
+			Hydrate:    getListAbuseReport,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The id for the abuse report.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The campaign id for the abuse report.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id for the abuse report.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "date",
+				Description: "Date for the abuse report.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "email_id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailID"),
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListAbuseReports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_abuse_report.listListAbuseReports", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/abuse-reports", listId)
+	last := 0
+
+	for {
+		abuseReports := new(listOfListAbuseReports)
+		err := client.Request("GET", endpoint, &params, nil, abuseReports)
+		if err != nil {
+			logger.Error("mailchimp_list_abuse_report.listListAbuseReports", "api_error", err)
+			return nil, err
+		}
+
+		for _, abuseReport := range abuseReports.AbuseReports {
+			d.StreamListItem(ctx, &abuseReport)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(abuseReports.AbuseReports)
+		if last >= abuseReports.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListAbuseReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQuals["id"].GetInt64Value()
+	listId := d.EqualsQualString("list_id")
+
+	// Abuse report id and list id should not be empty
+	if id == 0 || listId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_abuse_report.getListAbuseReport", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/abuse-reports/%d", listId, id)
+	abuseReport := new(listAbuseReport)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, abuseReport)
+	if err != nil {
+		logger.Error("mailchimp_list_abuse_report.getListAbuseReport", "api_error", err)
+		return nil, err
+	}
+
+	return abuseReport, nil
+}
@@ -0,0 +1,149 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listActivity struct {
+	gochimp3.Activity
+	ListID string
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListActivity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_activity",
+		Description: "Get up to the previous 180 days of daily detailed aggregated activity stats for a list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListActivities,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "list_id",
+				Description: "The unique id for the list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "day",
+				Description: "The date for the activity summary.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "emails_sent",
+				Description: "The total number of emails sent on the date for the activity summary.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "hard_bounce",
+				Description: "The number of hard bounces.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "other_adds",
+				Description: "The number of subscribers who may have been added outside of the double opt-in process, such as imports or API activity.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "other_removes",
+				Description: "The number of subscribers who may have been removed outside of unsubscribing or reporting an email as spam (for example, deleted subscribers).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "recipient_clicks",
+				Description: "The number of clicks.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "soft_bounce",
+				Description: "The number of soft bounces.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "subs",
+				Description: "The number of subscribes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unique_opens",
+				Description: "The number of unique opens.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unsubs",
+				Description: "The number of unsubscribes.",
+				Type:        proto.ColumnType_INT,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListActivities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_activity.listListActivities", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/activity", listId)
+	last := 0
+
+	for {
+		activities := new(gochimp3.ListOfActivity)
+		err := client.Request("GET", endpoint, &params, nil, activities)
+		if err != nil {
+			logger.Error("mailchimp_list_activity.listListActivities", "api_error", err)
+			return nil, err
+		}
+
+		for _, activity := range activities.Activities {
+			d.StreamListItem(ctx, &listActivity{Activity: activity, ListID: listId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(activities.Activities)
+		if len(activities.Activities) == 0 || last >= activities.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package mailchimp
+
+import (
+	"context"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMailchimpListClient(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_client",
+		Description: "Get a list of the top email clients based on user-agent strings.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListClients,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "list_id",
+				Description: "The unique id for the list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "client",
+				Description: "The name of the email client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "members",
+				Description: "The number of list members using the email client.",
+				Type:        proto.ColumnType_INT,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListClients(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_client.listListClients", "connection_error", err)
+		return nil, err
+	}
+
+	params := gochimp3.BasicQueryParams{}
+
+	clients, err := client.NewListResponse(listId).GetClients(&params)
+	if err != nil {
+		logger.Error("mailchimp_list_client.listListClients", "api_error", err)
+		return nil, err
+	}
+
+	for _, emailClient := range clients.Clients {
+		emailClient.ListID = listId
+		d.StreamListItem(ctx, &emailClient)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
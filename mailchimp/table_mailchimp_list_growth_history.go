@@ -0,0 +1,190 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listGrowthHistory struct {
+	ListID        string `json:"list_id"`
+	Month         string `json:"month"`
+	Existing      int    `json:"existing"`
+	Imports       int    `json:"imports"`
+	OptIns        int    `json:"optins"`
+	Subscribed    int    `json:"subscribed"`
+	Unsubscribed  int    `json:"unsubscribed"`
+	Reconfirm     int    `json:"reconfirm"`
+	Cleaned       int    `json:"cleaned"`
+	Pending       int    `json:"pending"`
+	Deleted       int    `json:"deleted"`
+	Transactional int    `json:"transactional"`
+}
+
+type listOfListGrowthHistory struct {
+	ListID     string              `json:"list_id"`
+	History    []listGrowthHistory `json:"history"`
+	TotalItems int                 `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListGrowthHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_growth_history",
+		Description: "Get a month-by-month summary of a specific list's growth activity.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListGrowthHistory,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id", "month"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "list_id",
+				Description: "The list id for the growth activity report.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "month",
+				Description: "The month that the growth history is describing, in the format YYYY-MM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cleaned",
+				Description: "Newly cleaned (hard-bounced) members on the list for a specific month.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "deleted",
+				Description: "Newly deleted members on the list for a specific month.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "existing",
+				Description: "The number of existing subscribers on the list for the month.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "imports",
+				Description: "The number of subscribers imported to the list for the month.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "optins",
+				Description: "The number of subscribers who opted in to the list for the month.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("OptIns"),
+			},
+			{
+				Name:        "pending",
+				Description: "Pending members on the list for a specific month.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "reconfirm",
+				Description: "Newly reconfirmed members on the list for a specific month.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "subscribed",
+				Description: "Total subscribed members on the list at the end of the month.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "transactional",
+				Description: "Subscribers that have been sent transactional emails via Mandrill.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unsubscribed",
+				Description: "Newly unsubscribed members on the list for a specific month.",
+				Type:        proto.ColumnType_INT,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListGrowthHistory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_growth_history.listListGrowthHistory", "connection_error", err)
+		return nil, err
+	}
+
+	// Get the growth history of a single month
+	if d.EqualsQualString("month") != "" {
+		endpoint := fmt.Sprintf("/lists/%s/growth-history/%s", listId, d.EqualsQualString("month"))
+		history := new(listGrowthHistory)
+
+		err := client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, history)
+		if err != nil {
+			// There is no growth history for months outside of the list's lifetime
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_list_growth_history.listListGrowthHistory", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, history)
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/growth-history", listId)
+	last := 0
+
+	for {
+		histories := new(listOfListGrowthHistory)
+		err := client.Request("GET", endpoint, &params, nil, histories)
+		if err != nil {
+			logger.Error("mailchimp_list_growth_history.listListGrowthHistory", "api_error", err)
+			return nil, err
+		}
+
+		for _, history := range histories.History {
+			d.StreamListItem(ctx, &history)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(histories.History)
+		if last >= histories.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
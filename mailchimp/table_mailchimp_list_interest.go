@@ -0,0 +1,218 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listInterest struct {
+	ID              string `json:"id"`
+	CategoryID      string `json:"category_id"`
+	ListID          string `json:"list_id"`
+	Name            string `json:"name"`
+	SubscriberCount string `json:"subscriber_count"`
+	DisplayOrder    int    `json:"display_order"`
+}
+
+type listOfListInterests struct {
+	Interests  []listInterest `json:"interests"`
+	CategoryID string         `json:"category_id"`
+	ListID     string         `json:"list_id"`
+	TotalItems int            `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListInterest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_interest",
+		Description: "Get information about a list's interests (group names) in a specific category.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListInterests,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id", "category_id"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "category_id", "list_id"}),
+			Hydrate:    getListInterest,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID for the interest.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the interest.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category_id",
+				Description: "The id for the interest category.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CategoryID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The ID for the list that this interest belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "display_order",
+				Description: "The display order for interests.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "subscriber_count",
+				Description: "The number of subscribers associated with this interest.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SubscriberCount").NullIfZero().Transform(transform.ToInt),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListInterests(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_interest.listListInterests", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	var categoryIds []string
+	if d.EqualsQuals["category_id"] != nil {
+		categoryIds = append(categoryIds, d.EqualsQualString("category_id"))
+	} else {
+		params := gochimp3.InterestCategoriesQueryParams{
+			ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+				Count:  1000,
+				Offset: 0,
+			},
+		}
+
+		for {
+			categories, err := client.NewListResponse(listId).GetInterestCategories(&params)
+			if err != nil {
+				logger.Error("mailchimp_list_interest.listListInterests", "api_error", err)
+				return nil, err
+			}
+
+			for _, category := range categories.Categories {
+				categoryIds = append(categoryIds, category.ID)
+			}
+
+			params.Offset = params.Offset + len(categories.Categories)
+			if len(categories.Categories) == 0 || params.Offset >= categories.TotalItems {
+				break
+			}
+		}
+	}
+
+	for _, categoryId := range categoryIds {
+		params := gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		}
+		endpoint := fmt.Sprintf("/lists/%s/interest-categories/%s/interests", listId, categoryId)
+		last := 0
+
+		for {
+			interests := new(listOfListInterests)
+			err := client.Request("GET", endpoint, &params, nil, interests)
+			if err != nil {
+				// The interest category only exists in one list, so other lists return a not found error
+				if d.EqualsQuals["category_id"] != nil && isNotFoundError([]string{"404"})(err) {
+					return nil, nil
+				}
+				logger.Error("mailchimp_list_interest.listListInterests", "api_error", err)
+				return nil, err
+			}
+
+			for _, interest := range interests.Interests {
+				d.StreamListItem(ctx, &interest)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			last = params.Offset + len(interests.Interests)
+			if len(interests.Interests) == 0 || last >= interests.TotalItems {
+				break
+			}
+			params.Offset = last
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListInterest(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQualString("id")
+	categoryId := d.EqualsQualString("category_id")
+	listId := d.EqualsQualString("list_id")
+
+	// Interest id, interest category id and list id should not be empty
+	if id == "" || categoryId == "" || listId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_interest.getListInterest", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/interest-categories/%s/interests/%s", listId, categoryId, id)
+	interest := new(listInterest)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, interest)
+	if err != nil {
+		logger.Error("mailchimp_list_interest.getListInterest", "api_error", err)
+		return nil, err
+	}
+
+	return interest, nil
+}
@@ -0,0 +1,155 @@
+package mailchimp
+
+import (
+	"context"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMailchimpListInterestCategory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_interest_category",
+		Description: "Get information about a list's interest categories (group titles).",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListInterestCategories,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id", "type"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "list_id"}),
+			Hydrate:    getListInterestCategory,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The id for the interest category.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The unique list id for the category.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "display_order",
+				Description: "The order that the categories are displayed in the list. Lower numbers display first.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "Determines how this category's interests appear on signup forms. Possible values: 'checkboxes', 'dropdown', 'radio' or 'hidden'.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The text description of this category.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListInterestCategories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_interest_category.listListInterestCategories", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.InterestCategoriesQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.EqualsQuals["type"] != nil {
+		params.Type = d.EqualsQualString("type")
+	}
+
+	list := client.NewListResponse(listId)
+	last := 0
+
+	for {
+		categories, err := list.GetInterestCategories(&params)
+		if err != nil {
+			logger.Error("mailchimp_list_interest_category.listListInterestCategories", "api_error", err)
+			return nil, err
+		}
+
+		for _, category := range categories.Categories {
+			d.StreamListItem(ctx, &category)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(categories.Categories)
+		if last >= categories.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListInterestCategory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQualString("id")
+	listId := d.EqualsQualString("list_id")
+
+	// Interest category id and list id should not be empty
+	if id == "" || listId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_interest_category.getListInterestCategory", "connection_error", err)
+		return nil, err
+	}
+
+	params := gochimp3.BasicQueryParams{}
+
+	category, err := client.NewListResponse(listId).GetInterestCategory(id, &params)
+	if err != nil {
+		logger.Error("mailchimp_list_interest_category.getListInterestCategory", "api_error", err)
+		return nil, err
+	}
+
+	return category, nil
+}
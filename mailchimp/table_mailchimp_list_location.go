@@ -0,0 +1,108 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listLocation struct {
+	Country string  `json:"country"`
+	CC      string  `json:"cc"`
+	Percent float64 `json:"percent"`
+	Total   int     `json:"total"`
+	ListID  string
+}
+
+type listOfListLocations struct {
+	ListID     string         `json:"list_id"`
+	Locations  []listLocation `json:"locations"`
+	TotalItems int            `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListLocation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_location",
+		Description: "Get the locations (countries) that the list's subscribers have been tagged to based on geocoding their IP address.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListLocations,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "list_id",
+				Description: "The unique id for the list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "country",
+				Description: "The name of the country.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cc",
+				Description: "The ISO 3166 2 digit country code.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CC"),
+			},
+			{
+				Name:        "percent",
+				Description: "The percent of subscribers in the country.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "total",
+				Description: "The total number of subscribers in the country.",
+				Type:        proto.ColumnType_INT,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListLocations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_location.listListLocations", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/locations", listId)
+	locations := new(listOfListLocations)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, locations)
+	if err != nil {
+		logger.Error("mailchimp_list_location.listListLocations", "api_error", err)
+		return nil, err
+	}
+
+	for _, location := range locations.Locations {
+		location.ListID = listId
+		d.StreamListItem(ctx, &location)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
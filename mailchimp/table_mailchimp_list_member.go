@@ -0,0 +1,438 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listMember struct {
+	ID                   string                   `json:"id"`
+	EmailAddress         string                   `json:"email_address"`
+	UniqueEmailID        string                   `json:"unique_email_id"`
+	ContactID            string                   `json:"contact_id"`
+	FullName             string                   `json:"full_name"`
+	WebID                int                      `json:"web_id"`
+	EmailType            string                   `json:"email_type"`
+	Status               string                   `json:"status"`
+	UnsubscribeReason    string                   `json:"unsubscribe_reason"`
+	MergeFields          map[string]interface{}   `json:"merge_fields"`
+	Interests            map[string]bool          `json:"interests"`
+	Stats                map[string]interface{}   `json:"stats"`
+	IPSignup             string                   `json:"ip_signup"`
+	TimestampSignup      string                   `json:"timestamp_signup"`
+	IPOpt                string                   `json:"ip_opt"`
+	TimestampOpt         string                   `json:"timestamp_opt"`
+	MemberRating         int                      `json:"member_rating"`
+	LastChanged          string                   `json:"last_changed"`
+	Language             string                   `json:"language"`
+	VIP                  bool                     `json:"vip"`
+	EmailClient          string                   `json:"email_client"`
+	Location             map[string]interface{}   `json:"location"`
+	MarketingPermissions []map[string]interface{} `json:"marketing_permissions"`
+	LastNote             map[string]interface{}   `json:"last_note"`
+	Source               string                   `json:"source"`
+	TagsCount            int                      `json:"tags_count"`
+	Tags                 []gochimp3.MemberTag     `json:"tags"`
+	ListID               string                   `json:"list_id"`
+}
+
+type listOfListMembers struct {
+	ListID     string       `json:"list_id"`
+	Members    []listMember `json:"members"`
+	TotalItems int          `json:"total_items"`
+}
+
+type listMemberQueryParams struct {
+	gochimp3.ExtendedQueryParams
+
+	EmailType          string
+	VIPOnly            bool
+	SinceLastChanged   string
+	BeforeLastChanged  string
+	SinceTimestampOpt  string
+	BeforeTimestampOpt string
+}
+
+func (q *listMemberQueryParams) Params() map[string]string {
+	m := q.ExtendedQueryParams.Params()
+	m["email_type"] = q.EmailType
+	m["since_last_changed"] = q.SinceLastChanged
+	m["before_last_changed"] = q.BeforeLastChanged
+	m["since_timestamp_opt"] = q.SinceTimestampOpt
+	m["before_timestamp_opt"] = q.BeforeTimestampOpt
+	if q.VIPOnly {
+		m["vip_only"] = "true"
+	}
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_member",
+		Description: "Get information about members in a specific Mailchimp list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListMembers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "list_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "email_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "vip",
+					Require: plugin.Optional,
+				},
+				{
+					Name:       "last_changed",
+					Operators:  []string{">", ">=", "<", "<=", "="},
+					Require:    plugin.Optional,
+					CacheMatch: "exact",
+				},
+				{
+					Name:       "timestamp_opt",
+					Operators:  []string{">", ">=", "<", "<=", "="},
+					Require:    plugin.Optional,
+					CacheMatch: "exact",
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "list_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "email_address",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "subscriber_hash",
+					Require: plugin.AnyOf,
+				},
+			},
+			Hydrate: getListMember,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "The list ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "contact_id",
+				Description: "As Mailchimp evolves beyond email, you may eventually have contacts without email addresses.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContactID"),
+			},
+			{
+				Name:        "email_client",
+				Description: "The list member's email client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_type",
+				Description: "Type of email this member asked to get ('html' or 'text').",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "full_name",
+				Description: "The contact's full name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_opt",
+				Description: "The IP address the subscriber used to confirm their opt-in status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IPOpt"),
+			},
+			{
+				Name:        "ip_signup",
+				Description: "IP address the subscriber signed up from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IPSignup"),
+			},
+			{
+				Name:        "language",
+				Description: "If set/detected, the subscriber's language.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_changed",
+				Description: "The date and time the member's info was last changed in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "member_rating",
+				Description: "Star rating for this member, between 1 and 5.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "subscriber_hash",
+				Description: "The MD5 hash of the lowercase version of the member's email address, used to identify the member in the API.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress").Transform(subscriberHash),
+			},
+			{
+				Name:        "source",
+				Description: "The source from which the subscriber was added to this list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Subscriber's current status. Possible values: 'subscribed', 'unsubscribed', 'cleaned', 'pending', 'transactional' or 'archived'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_count",
+				Description: "The number of tags applied to this member.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "timestamp_opt",
+				Description: "The date and time the subscriber confirmed their opt-in status in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "timestamp_signup",
+				Description: "The date and time the subscriber signed up for the list in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "unique_email_id",
+				Description: "An identifier for the address across all of Mailchimp.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UniqueEmailID"),
+			},
+			{
+				Name:        "unsubscribe_reason",
+				Description: "A subscriber's reason for unsubscribing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+			{
+				Name:        "web_id",
+				Description: "The ID used in the Mailchimp web application.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("WebID"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "interests",
+				Description: "The key of this object's properties is the ID of the interest in question.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "last_note",
+				Description: "The most recent Note added about this member.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location",
+				Description: "Subscriber location information.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "marketing_permissions",
+				Description: "The marketing permissions for the subscriber.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "stats",
+				Description: "Open and click rates for this subscriber.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags",
+				Description: "Returns up to 50 tags applied to this member.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_member.listListMembers", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := listMemberQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.EqualsQuals["status"] != nil {
+		params.Status = d.EqualsQualString("status")
+	}
+	if d.EqualsQuals["email_type"] != nil {
+		params.EmailType = d.EqualsQualString("email_type")
+	}
+	if d.EqualsQuals["vip"] != nil {
+		params.VIPOnly = d.EqualsQuals["vip"].GetBoolValue()
+	}
+	if d.Quals["last_changed"] != nil {
+		for _, q := range d.Quals["last_changed"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			timestampAdd := q.Value.GetTimestampValue().AsTime().Add(time.Second).Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				params.SinceLastChanged = timestamp
+			case "<":
+				params.BeforeLastChanged = timestamp
+			case "<=":
+				params.BeforeLastChanged = timestampAdd
+			case "=":
+				params.SinceLastChanged = timestamp
+				params.BeforeLastChanged = timestampAdd
+			}
+		}
+	}
+	if d.Quals["timestamp_opt"] != nil {
+		for _, q := range d.Quals["timestamp_opt"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			timestampAdd := q.Value.GetTimestampValue().AsTime().Add(time.Second).Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				params.SinceTimestampOpt = timestamp
+			case "<":
+				params.BeforeTimestampOpt = timestamp
+			case "<=":
+				params.BeforeTimestampOpt = timestampAdd
+			case "=":
+				params.SinceTimestampOpt = timestamp
+				params.BeforeTimestampOpt = timestampAdd
+			}
+		}
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/members", listId)
+	last := 0
+
+	for {
+		members := new(listOfListMembers)
+		err := client.Request("GET", endpoint, &params, nil, members)
+		if err != nil {
+			logger.Error("mailchimp_list_member.listListMembers", "api_error", err)
+			return nil, err
+		}
+
+		for _, member := range members.Members {
+			d.StreamListItem(ctx, &member)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(members.Members)
+		if last >= members.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListMember(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := d.EqualsQualString("list_id")
+	subscriberHash := getMemberSubscriberHash(d)
+
+	// List id and member identifier should not be empty
+	if listId == "" || subscriberHash == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_member.getListMember", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/members/%s", listId, subscriberHash)
+	member := new(listMember)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, member)
+	if err != nil {
+		logger.Error("mailchimp_list_member.getListMember", "api_error", err)
+		return nil, err
+	}
+
+	return member, nil
+}
@@ -0,0 +1,223 @@
+package mailchimp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listMemberActivity struct {
+	ActivityType       string `json:"activity_type"`
+	CreatedAtTimestamp string `json:"created_at_timestamp"`
+	CampaignID         string `json:"campaign_id"`
+	CampaignTitle      string `json:"campaign_title"`
+	LinkClicked        string `json:"link_clicked"`
+	ListID             string
+	SubscriberHash     string
+	Details            map[string]interface{}
+}
+
+type listOfListMemberActivities struct {
+	EmailID    string            `json:"email_id"`
+	ListID     string            `json:"list_id"`
+	Activity   []json.RawMessage `json:"activity"`
+	TotalItems int               `json:"total_items"`
+}
+
+type listMemberActivityQueryParams struct {
+	gochimp3.ExtendedQueryParams
+
+	ActivityFilters string
+}
+
+func (q *listMemberActivityQueryParams) Params() map[string]string {
+	m := q.ExtendedQueryParams.Params()
+	m["activity_filters"] = q.ActivityFilters
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListMemberActivity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_member_activity",
+		Description: "Get a member's activity on a specific list, including opens, clicks, and unsubscribes.",
+		List: &plugin.ListConfig{
+			Hydrate: listListMemberActivities,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "list_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "email_address",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "subscriber_hash",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "activity_filters",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "activity_type",
+				Description: "The type of the activity, such as 'open', 'click', 'bounce', 'unsub', 'sent', 'conversation', 'note', 'marketing_permission', 'postcard', 'squatter', 'website', 'survey_response', 'signup', 'ecommerce_signup', 'generic_activity', 'contact_activity_event', 'event' or 'inbox_thread'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at_timestamp",
+				Description: "The date and time the activity happened in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address of the list member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("email_address"),
+			},
+			{
+				Name:        "subscriber_hash",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "activity_filters",
+				Description: "A comma-separated list of activity types used to filter the activity feed, for example 'open,bounce,click'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("activity_filters"),
+			},
+			{
+				Name:        "campaign_id",
+				Description: "The unique id for the campaign related to the activity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignID"),
+			},
+			{
+				Name:        "campaign_title",
+				Description: "The title of the campaign related to the activity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "link_clicked",
+				Description: "The URL of the link that was clicked, for click activities.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+
+			{
+				Name:        "details",
+				Description: "The full activity record, including the properties that are specific to the activity type.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CampaignTitle"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListMemberActivities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := d.EqualsQualString("list_id")
+	subscriberHash := getMemberSubscriberHash(d)
+
+	// List id and member identifier should not be empty
+	if listId == "" || subscriberHash == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_member_activity.listListMemberActivities", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := listMemberActivityQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.EqualsQuals["activity_filters"] != nil {
+		params.ActivityFilters = d.EqualsQualString("activity_filters")
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/members/%s/activity-feed", listId, subscriberHash)
+	last := 0
+
+	for {
+		activities := new(listOfListMemberActivities)
+		err := client.Request("GET", endpoint, &params, nil, activities)
+		if err != nil {
+			// The member does not exist in the list
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_list_member_activity.listListMemberActivities", "api_error", err)
+			return nil, err
+		}
+
+		for _, raw := range activities.Activity {
+			activity := listMemberActivity{
+				ListID:         listId,
+				SubscriberHash: subscriberHash,
+			}
+			if err := json.Unmarshal(raw, &activity); err != nil {
+				logger.Error("mailchimp_list_member_activity.listListMemberActivities", "unmarshal_error", err)
+				return nil, err
+			}
+			if err := json.Unmarshal(raw, &activity.Details); err != nil {
+				logger.Error("mailchimp_list_member_activity.listListMemberActivities", "unmarshal_error", err)
+				return nil, err
+			}
+			d.StreamListItem(ctx, &activity)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(activities.Activity)
+		if len(activities.Activity) == 0 || last >= activities.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
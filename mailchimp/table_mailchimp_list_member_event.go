@@ -0,0 +1,164 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listMemberEvent struct {
+	Name           string                 `json:"name"`
+	Properties     map[string]interface{} `json:"properties"`
+	OccurredAt     string                 `json:"occurred_at"`
+	ListID         string
+	SubscriberHash string
+}
+
+type listOfListMemberEvents struct {
+	Events     []listMemberEvent `json:"events"`
+	TotalItems int               `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListMemberEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_member_event",
+		Description: "Get events for a contact.",
+		List: &plugin.ListConfig{
+			Hydrate: listListMemberEvents,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "list_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "email_address",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "subscriber_hash",
+					Require: plugin.AnyOf,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name for this type of event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "occurred_at",
+				Description: "The date and time the event occurred in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "list_id",
+				Description: "The unique id for the list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address of the list member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("email_address"),
+			},
+			{
+				Name:        "subscriber_hash",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+
+			{
+				Name:        "properties",
+				Description: "A map of property names to values that were recorded with the event.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListMemberEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := d.EqualsQualString("list_id")
+	subscriberHash := getMemberSubscriberHash(d)
+
+	// List id and member identifier should not be empty
+	if listId == "" || subscriberHash == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_member_event.listListMemberEvents", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/members/%s/events", listId, subscriberHash)
+	last := 0
+
+	for {
+		events := new(listOfListMemberEvents)
+		err := client.Request("GET", endpoint, &params, nil, events)
+		if err != nil {
+			// The member does not exist in the list
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_list_member_event.listListMemberEvents", "api_error", err)
+			return nil, err
+		}
+
+		for _, event := range events.Events {
+			event.ListID = listId
+			event.SubscriberHash = subscriberHash
+			d.StreamListItem(ctx, &event)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(events.Events)
+		if last >= events.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
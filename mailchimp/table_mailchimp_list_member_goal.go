@@ -0,0 +1,135 @@
+package mailchimp
+
+import (
+	"context"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listMemberGoal struct {
+	gochimp3.MemberGoal
+	ListID         string
+	SubscriberHash string
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListMemberGoal(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_member_goal",
+		Description: "Get the last 50 Goal events for a member on a specific list.",
+		List: &plugin.ListConfig{
+			Hydrate: listListMemberGoals,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "list_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "email_address",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "subscriber_hash",
+					Require: plugin.AnyOf,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "goal_id",
+				Description: "The id for a Goal event.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "event",
+				Description: "The name/type of Goal event triggered.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address of the list member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("email_address"),
+			},
+			{
+				Name:        "subscriber_hash",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data",
+				Description: "Custom data about the Goal event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_visited_at",
+				Description: "The date and time the user last triggered the Goal event in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Event"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListMemberGoals(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := d.EqualsQualString("list_id")
+	subscriberHash := getMemberSubscriberHash(d)
+
+	// List id and member identifier should not be empty
+	if listId == "" || subscriberHash == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_member_goal.listListMemberGoals", "connection_error", err)
+		return nil, err
+	}
+
+	params := gochimp3.BasicQueryParams{}
+
+	member := (&gochimp3.Member{ListID: listId, ID: subscriberHash}).WithApi(client)
+	goals, err := member.GetGoals(&params)
+	if err != nil {
+		// The member does not exist in the list
+		if isNotFoundError([]string{"404"})(err) {
+			return nil, nil
+		}
+		logger.Error("mailchimp_list_member_goal.listListMemberGoals", "api_error", err)
+		return nil, err
+	}
+
+	for _, goal := range goals.Goals {
+		d.StreamListItem(ctx, &listMemberGoal{MemberGoal: goal, ListID: listId, SubscriberHash: subscriberHash})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
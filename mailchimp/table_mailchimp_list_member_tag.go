@@ -0,0 +1,152 @@
+package mailchimp
+
+import (
+	"context"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listMemberTag struct {
+	gochimp3.MemberTagLong
+	ListID         string
+	SubscriberHash string
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListMemberTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_member_tag",
+		Description: "Get the tags on a list member.",
+		List: &plugin.ListConfig{
+			Hydrate: listListMemberTags,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "list_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "email_address",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "subscriber_hash",
+					Require: plugin.AnyOf,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The tag id.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address of the list member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("email_address"),
+			},
+			{
+				Name:        "subscriber_hash",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "date_added",
+				Description: "The date and time the tag was added to the list member in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DataAdded"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListMemberTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := d.EqualsQualString("list_id")
+	subscriberHash := getMemberSubscriberHash(d)
+
+	// List id and member identifier should not be empty
+	if listId == "" || subscriberHash == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_member_tag.listListMemberTags", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.ExtendedQueryParams{
+		Count:  int(maxLimit),
+		Offset: 0,
+	}
+
+	member := (&gochimp3.Member{ListID: listId, ID: subscriberHash}).WithApi(client)
+	last := 0
+
+	for {
+		tags, err := member.GetTags(&params)
+		if err != nil {
+			// The member does not exist in the list
+			if isNotFoundError([]string{"404"})(err) {
+				return nil, nil
+			}
+			logger.Error("mailchimp_list_member_tag.listListMemberTags", "api_error", err)
+			return nil, err
+		}
+
+		for _, tag := range tags.Tags {
+			d.StreamListItem(ctx, &listMemberTag{MemberTagLong: tag, ListID: listId, SubscriberHash: subscriberHash})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(tags.Tags)
+		if last >= tags.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listMergeField struct {
+	MergeID      int                    `json:"merge_id"`
+	Tag          string                 `json:"tag"`
+	Name         string                 `json:"name"`
+	Type         string                 `json:"type"`
+	Required     bool                   `json:"required"`
+	DefaultValue string                 `json:"default_value"`
+	Public       bool                   `json:"public"`
+	DisplayOrder int                    `json:"display_order"`
+	Options      map[string]interface{} `json:"options"`
+	HelpText     string                 `json:"help_text"`
+	ListID       string                 `json:"list_id"`
+}
+
+type listOfListMergeFields struct {
+	ListID      string           `json:"list_id"`
+	MergeFields []listMergeField `json:"merge_fields"`
+	TotalItems  int              `json:"total_items"`
+}
+
+type listMergeFieldQueryParams struct {
+	gochimp3.ExtendedQueryParams
+
+	Type     string
+	Required string
+}
+
+func (q *listMergeFieldQueryParams) Params() map[string]string {
+	m := q.ExtendedQueryParams.Params()
+	m["type"] = q.Type
+	m["required"] = q.Required
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListMergeField(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_merge_field",
+		Description: "Get information about the merge fields (audience fields) of a list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListMergeFields,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id", "type", "required"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"merge_id", "list_id"}),
+			Hydrate:    getListMergeField,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "merge_id",
+				Description: "An unchanging id for the merge field.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MergeID"),
+			},
+			{
+				Name:        "tag",
+				Description: "The tag used in Mailchimp campaigns and for the /members endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the merge field (audience field).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "A string that identifies this merge field's list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "default_value",
+				Description: "The default value for the merge field if null.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_order",
+				Description: "The order that the merge field displays on the list signup form.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "help_text",
+				Description: "Extra text to help the subscriber fill out the form.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "public",
+				Description: "Whether the merge field is displayed on the signup form.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "required",
+				Description: "The boolean value if the merge field is required.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "type",
+				Description: "The type for the merge field.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+
+			{
+				Name:        "options",
+				Description: "Extra options for some merge field types.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListMergeFields(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_merge_field.listListMergeFields", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := listMergeFieldQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.EqualsQuals["type"] != nil {
+		params.Type = d.EqualsQualString("type")
+	}
+	if d.EqualsQuals["required"] != nil {
+		params.Required = fmt.Sprintf("%t", d.EqualsQuals["required"].GetBoolValue())
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/merge-fields", listId)
+	last := 0
+
+	for {
+		mergeFields := new(listOfListMergeFields)
+		err := client.Request("GET", endpoint, &params, nil, mergeFields)
+		if err != nil {
+			logger.Error("mailchimp_list_merge_field.listListMergeFields", "api_error", err)
+			return nil, err
+		}
+
+		for _, mergeField := range mergeFields.MergeFields {
+			d.StreamListItem(ctx, &mergeField)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(mergeFields.MergeFields)
+		if last >= mergeFields.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListMergeField(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	mergeId := d.EqualsQuals["merge_id"].GetInt64Value()
+	listId := d.EqualsQualString("list_id")
+
+	// List id should not be empty
+	if listId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_merge_field.getListMergeField", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/merge-fields/%d", listId, mergeId)
+	mergeField := new(listMergeField)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, mergeField)
+	if err != nil {
+		logger.Error("mailchimp_list_merge_field.getListMergeField", "api_error", err)
+		return nil, err
+	}
+
+	return mergeField, nil
+}
@@ -0,0 +1,255 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listSegment struct {
+	ID          int                    `json:"id"`
+	Name        string                 `json:"name"`
+	MemberCount int                    `json:"member_count"`
+	Type        string                 `json:"type"`
+	CreatedAt   string                 `json:"created_at"`
+	UpdatedAt   string                 `json:"updated_at"`
+	Options     map[string]interface{} `json:"options"`
+	ListID      string                 `json:"list_id"`
+}
+
+type listOfListSegments struct {
+	ListID     string        `json:"list_id"`
+	Segments   []listSegment `json:"segments"`
+	TotalItems int           `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListSegment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_segment",
+		Description: "Get information about all available segments and tags for a specific list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListSegments,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "list_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:       "created_at",
+					Operators:  []string{">", ">=", "<", "<=", "="},
+					Require:    plugin.Optional,
+					CacheMatch: "exact",
+				},
+				{
+					Name:       "updated_at",
+					Operators:  []string{">", ">=", "<", "<=", "="},
+					Require:    plugin.Optional,
+					CacheMatch: "exact",
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "list_id"}),
+			Hydrate:    getListSegment,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique id for the segment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the segment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time the segment was created in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "member_count",
+				Description: "The number of active subscribers currently included in the segment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The type of segment. Static segments are now known as tags. Possible values: 'saved', 'static' or 'fuzzy'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time the segment was last updated in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// JSON fields
+
+			{
+				Name:        "options",
+				Description: "The conditions of the segment. Static segments (tags) and fuzzy segments don't have conditions.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListSegments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_segment.listListSegments", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := gochimp3.SegmentQueryParams{
+		ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		},
+	}
+	if d.EqualsQuals["type"] != nil {
+		params.Type = d.EqualsQualString("type")
+	}
+	if d.Quals["created_at"] != nil {
+		for _, q := range d.Quals["created_at"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			timestampAdd := q.Value.GetTimestampValue().AsTime().Add(time.Second).Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				params.SinceCreatedAt = timestamp
+			case "<":
+				params.BeforeCreatedAt = timestamp
+			case "<=":
+				params.BeforeCreatedAt = timestampAdd
+			case "=":
+				params.SinceCreatedAt = timestamp
+				params.BeforeCreatedAt = timestampAdd
+			}
+		}
+	}
+	if d.Quals["updated_at"] != nil {
+		for _, q := range d.Quals["updated_at"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			timestampAdd := q.Value.GetTimestampValue().AsTime().Add(time.Second).Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				params.SinceUpdatedAt = timestamp
+			case "<":
+				params.BeforeUpdatedAt = timestamp
+			case "<=":
+				params.BeforeUpdatedAt = timestampAdd
+			case "=":
+				params.SinceUpdatedAt = timestamp
+				params.BeforeUpdatedAt = timestampAdd
+			}
+		}
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/segments", listId)
+	last := 0
+
+	for {
+		segments := new(listOfListSegments)
+		err := client.Request("GET", endpoint, &params, nil, segments)
+		if err != nil {
+			logger.Error("mailchimp_list_segment.listListSegments", "api_error", err)
+			return nil, err
+		}
+
+		for _, segment := range segments.Segments {
+			d.StreamListItem(ctx, &segment)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = params.Offset + len(segments.Segments)
+		if last >= segments.TotalItems {
+			return nil, nil
+		} else {
+			params.Offset = last
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListSegment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQuals["id"].GetInt64Value()
+	listId := d.EqualsQualString("list_id")
+
+	// Segment id and list id should not be empty
+	if id == 0 || listId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_segment.getListSegment", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/segments/%d", listId, id)
+	segment := new(listSegment)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, segment)
+	if err != nil {
+		logger.Error("mailchimp_list_segment.getListSegment", "api_error", err)
+		return nil, err
+	}
+
+	return segment, nil
+}
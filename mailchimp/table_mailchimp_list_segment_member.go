@@ -0,0 +1,251 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listSegmentMember struct {
+	listMember
+	SegmentID int
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListSegmentMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_segment_member",
+		Description: "Get information about members in a saved segment.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListSegmentMembers,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id", "segment_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "segment_id",
+				Description: "The unique id for the segment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SegmentID"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The list ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "email_client",
+				Description: "The list member's email client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_type",
+				Description: "Type of email this member asked to get ('html' or 'text').",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_opt",
+				Description: "The IP address the subscriber used to confirm their opt-in status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IPOpt"),
+			},
+			{
+				Name:        "ip_signup",
+				Description: "IP address the subscriber signed up from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IPSignup"),
+			},
+			{
+				Name:        "language",
+				Description: "If set/detected, the subscriber's language.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_changed",
+				Description: "The date and time the member's info was last changed in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "member_rating",
+				Description: "Star rating for this member, between 1 and 5.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status",
+				Description: "Subscriber's current status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp_opt",
+				Description: "The date and time the subscriber confirmed their opt-in status in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "timestamp_signup",
+				Description: "The date and time the subscriber signed up for the list in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "unique_email_id",
+				Description: "An identifier for the address across all of Mailchimp.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UniqueEmailID"),
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "interests",
+				Description: "The key of this object's properties is the ID of the interest in question.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location",
+				Description: "Subscriber location information.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "stats",
+				Description: "Open and click rates for this subscriber.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListSegmentMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_segment_member.listListSegmentMembers", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	var segmentIds []int
+	if d.EqualsQuals["segment_id"] != nil {
+		segmentIds = append(segmentIds, int(d.EqualsQuals["segment_id"].GetInt64Value()))
+	} else {
+		params := gochimp3.SegmentQueryParams{
+			ExtendedQueryParams: gochimp3.ExtendedQueryParams{
+				BasicQueryParams: gochimp3.BasicQueryParams{
+					Fields: []string{"segments.id", "total_items"},
+				},
+				Count:  1000,
+				Offset: 0,
+			},
+		}
+		endpoint := fmt.Sprintf("/lists/%s/segments", listId)
+
+		for {
+			segments := new(listOfListSegments)
+			err := client.Request("GET", endpoint, &params, nil, segments)
+			if err != nil {
+				logger.Error("mailchimp_list_segment_member.listListSegmentMembers", "api_error", err)
+				return nil, err
+			}
+
+			for _, segment := range segments.Segments {
+				segmentIds = append(segmentIds, segment.ID)
+			}
+
+			params.Offset = params.Offset + len(segments.Segments)
+			if len(segments.Segments) == 0 || params.Offset >= segments.TotalItems {
+				break
+			}
+		}
+	}
+
+	for _, segmentId := range segmentIds {
+		params := gochimp3.ExtendedQueryParams{
+			Count:  int(maxLimit),
+			Offset: 0,
+		}
+		endpoint := fmt.Sprintf("/lists/%s/segments/%d/members", listId, segmentId)
+		last := 0
+
+		for {
+			members := new(listOfListMembers)
+			err := client.Request("GET", endpoint, &params, nil, members)
+			if err != nil {
+				// The segment only exists in one list, so other lists return a not found error
+				if d.EqualsQuals["segment_id"] != nil && isNotFoundError([]string{"404"})(err) {
+					return nil, nil
+				}
+				logger.Error("mailchimp_list_segment_member.listListSegmentMembers", "api_error", err)
+				return nil, err
+			}
+
+			for _, member := range members.Members {
+				d.StreamListItem(ctx, &listSegmentMember{listMember: member, SegmentID: segmentId})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			last = params.Offset + len(members.Members)
+			if len(members.Members) == 0 || last >= members.TotalItems {
+				break
+			}
+			params.Offset = last
+		}
+	}
+
+	return nil, nil
+}
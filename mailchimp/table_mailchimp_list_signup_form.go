@@ -0,0 +1,142 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listSignupForm struct {
+	Header        listSignupFormHeader     `json:"header"`
+	Contents      []map[string]interface{} `json:"contents"`
+	Styles        []map[string]interface{} `json:"styles"`
+	SignupFormURL string                   `json:"signup_form_url"`
+	ListID        string                   `json:"list_id"`
+}
+
+type listSignupFormHeader struct {
+	ImageURL         string `json:"image_url"`
+	Text             string `json:"text"`
+	ImageWidth       string `json:"image_width"`
+	ImageHeight      string `json:"image_height"`
+	ImageAlt         string `json:"image_alt"`
+	ImageLink        string `json:"image_link"`
+	ImageAlign       string `json:"image_align"`
+	ImageBorderWidth string `json:"image_border_width"`
+	ImageBorderStyle string `json:"image_border_style"`
+	ImageBorderColor string `json:"image_border_color"`
+	ImageTarget      string `json:"image_target"`
+}
+
+type listOfListSignupForms struct {
+	ListID      string           `json:"list_id"`
+	SignupForms []listSignupForm `json:"signup_forms"`
+	TotalItems  int              `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListSignupForm(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_signup_form",
+		Description: "Get signup forms for a specific list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListSignupForms,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "list_id",
+				Description: "The signup form's list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "signup_form_url",
+				Description: "Signup form URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SignupFormURL"),
+			},
+			{
+				Name:        "header_image_align",
+				Description: "Image alignment of the signup form header.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Header.ImageAlign"),
+			},
+			{
+				Name:        "header_image_url",
+				Description: "Header image URL of the signup form.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Header.ImageURL"),
+			},
+			{
+				Name:        "header_text",
+				Description: "Header text of the signup form.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Header.Text"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "contents",
+				Description: "The signup form body content.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "header",
+				Description: "Options for customizing your signup form header.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "styles",
+				Description: "An array of objects, each representing an element style for the signup form.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListSignupForms(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_signup_form.listListSignupForms", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/signup-forms", listId)
+	signupForms := new(listOfListSignupForms)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, signupForms)
+	if err != nil {
+		logger.Error("mailchimp_list_signup_form.listListSignupForms", "api_error", err)
+		return nil, err
+	}
+
+	for _, signupForm := range signupForms.SignupForms {
+		d.StreamListItem(ctx, &signupForm)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,183 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listSurvey struct {
+	ID          string                   `json:"id"`
+	WebID       int                      `json:"web_id"`
+	ListID      string                   `json:"list_id"`
+	ListName    string                   `json:"list_name"`
+	Title       string                   `json:"title"`
+	URL         string                   `json:"url"`
+	Status      string                   `json:"status"`
+	PublishedAt string                   `json:"published_at"`
+	CreatedAt   string                   `json:"created_at"`
+	UpdatedAt   string                   `json:"updated_at"`
+	Questions   []map[string]interface{} `json:"questions"`
+}
+
+type listOfListSurveys struct {
+	Surveys    []listSurvey `json:"surveys"`
+	TotalItems int          `json:"total_items"`
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListSurvey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_survey",
+		Description: "Get information about surveys for a specific list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListSurveys,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "list_id"}),
+			Hydrate:    getListSurvey,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique id for the survey.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "title",
+				Description: "The title of the survey.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "The id of the list connected to the survey.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "list_name",
+				Description: "The name of the list connected to the survey.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the survey. Possible values: 'published' or 'unpublished'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "url",
+				Description: "The URL for the survey.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+			{
+				Name:        "web_id",
+				Description: "The ID used in the Mailchimp web application.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("WebID"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time the survey was created in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "published_at",
+				Description: "The date and time the survey was published in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time the survey was last updated in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// JSON fields
+
+			{
+				Name:        "questions",
+				Description: "The questions of the survey, including their type, options and whether they are required.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListSurveys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_survey.listListSurveys", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/surveys", listId)
+	surveys := new(listOfListSurveys)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, surveys)
+	if err != nil {
+		logger.Error("mailchimp_list_survey.listListSurveys", "api_error", err)
+		return nil, err
+	}
+
+	for _, survey := range surveys.Surveys {
+		d.StreamListItem(ctx, &survey)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListSurvey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQualString("id")
+	listId := d.EqualsQualString("list_id")
+
+	// Survey id and list id should not be empty
+	if id == "" || listId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_survey.getListSurvey", "connection_error", err)
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/surveys/%s", listId, id)
+	survey := new(listSurvey)
+
+	err = client.Request("GET", endpoint, &gochimp3.BasicQueryParams{}, nil, survey)
+	if err != nil {
+		logger.Error("mailchimp_list_survey.getListSurvey", "api_error", err)
+		return nil, err
+	}
+
+	return survey, nil
+}
@@ -0,0 +1,120 @@
+package mailchimp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type listTag struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	ListID string
+}
+
+type listOfListTags struct {
+	Tags       []listTag `json:"tags"`
+	TotalItems int       `json:"total_items"`
+}
+
+type listTagQueryParams struct {
+	gochimp3.BasicQueryParams
+
+	Name string
+}
+
+func (q *listTagQueryParams) Params() map[string]string {
+	m := q.BasicQueryParams.Params()
+	m["name"] = q.Name
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpListTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_tag",
+		Description: "Search for tags on a list by name.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListTags,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id", "name"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique id for the tag.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "The list id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_tag.listListTags", "connection_error", err)
+		return nil, err
+	}
+
+	params := listTagQueryParams{}
+	if d.EqualsQuals["name"] != nil {
+		params.Name = d.EqualsQualString("name")
+	}
+
+	endpoint := fmt.Sprintf("/lists/%s/tag-search", listId)
+	tags := new(listOfListTags)
+
+	err = client.Request("GET", endpoint, &params, nil, tags)
+	if err != nil {
+		logger.Error("mailchimp_list_tag.listListTags", "api_error", err)
+		return nil, err
+	}
+
+	for _, tag := range tags.Tags {
+		tag.ListID = listId
+		d.StreamListItem(ctx, &tag)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
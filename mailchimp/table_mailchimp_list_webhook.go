@@ -0,0 +1,188 @@
+package mailchimp
+
+import (
+	"context"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMailchimpListWebhook(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_list_webhook",
+		Description: "Get information about the webhooks configured for a list.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLists,
+			Hydrate:       listListWebhooks,
+			KeyColumns:    plugin.OptionalColumns([]string{"list_id"}),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "list_id"}),
+			Hydrate:    getListWebhook,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "A string that uniquely identifies this webhook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "url",
+				Description: "A valid URL for the webhook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+			{
+				Name:        "list_id",
+				Description: "The unique id for the list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "event_campaign",
+				Description: "Whether the webhook is triggered when a campaign is sent or cancelled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Events.Campaign"),
+			},
+			{
+				Name:        "event_cleaned",
+				Description: "Whether the webhook is triggered when a subscriber's email address is cleaned from the list.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Events.Cleaned"),
+			},
+			{
+				Name:        "event_profile",
+				Description: "Whether the webhook is triggered when a subscriber's profile is updated.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Events.Profile"),
+			},
+			{
+				Name:        "event_subscribe",
+				Description: "Whether the webhook is triggered when a list subscriber is added.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Events.Subscribe"),
+			},
+			{
+				Name:        "event_unsubscribe",
+				Description: "Whether the webhook is triggered when a list member unsubscribes.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Events.Unsubscribe"),
+			},
+			{
+				Name:        "event_upemail",
+				Description: "Whether the webhook is triggered when a subscriber's email address is changed.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Events.Upemail"),
+			},
+			{
+				Name:        "source_admin",
+				Description: "Whether the webhook is triggered by admin-initiated actions in the web interface.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Sources.Admin"),
+			},
+			{
+				Name:        "source_api",
+				Description: "Whether the webhook is triggered by actions initiated via the API.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Sources.API"),
+			},
+			{
+				Name:        "source_user",
+				Description: "Whether the webhook is triggered by subscriber-initiated actions.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Sources.User"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "events",
+				Description: "The events that can trigger the webhook and whether they are enabled.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sources",
+				Description: "The possible sources of any events that can trigger the webhook and whether they are enabled.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listListWebhooks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	listId := h.Item.(gochimp3.ListResponse).ID
+
+	if d.EqualsQuals["list_id"] != nil && d.EqualsQualString("list_id") != listId {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_webhook.listListWebhooks", "connection_error", err)
+		return nil, err
+	}
+
+	webhooks, err := client.NewListResponse(listId).GetWebHooks()
+	if err != nil {
+		logger.Error("mailchimp_list_webhook.listListWebhooks", "api_error", err)
+		return nil, err
+	}
+
+	for _, webhook := range webhooks.WebHooks {
+		d.StreamListItem(ctx, &webhook)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getListWebhook(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	id := d.EqualsQualString("id")
+	listId := d.EqualsQualString("list_id")
+
+	// Webhook id and list id should not be empty
+	if id == "" || listId == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_list_webhook.getListWebhook", "connection_error", err)
+		return nil, err
+	}
+
+	webhook, err := client.NewListResponse(listId).GetWebHook(id)
+	if err != nil {
+		logger.Error("mailchimp_list_webhook.getListWebhook", "api_error", err)
+		return nil, err
+	}
+
+	return webhook, nil
+}
@@ -0,0 +1,218 @@
+package mailchimp
+
+import (
+	"context"
+
+	"github.com/hanzoai/gochimp3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type searchMember struct {
+	listMember
+	MatchType string
+}
+
+type searchMemberMatches struct {
+	Members    []listMember `json:"members"`
+	TotalItems int          `json:"total_items"`
+}
+
+type searchMembersResponse struct {
+	ExactMatches searchMemberMatches `json:"exact_matches"`
+	FullSearch   searchMemberMatches `json:"full_search"`
+}
+
+type searchMemberQueryParams struct {
+	gochimp3.BasicQueryParams
+
+	Query  string
+	ListID string
+}
+
+func (q *searchMemberQueryParams) Params() map[string]string {
+	m := q.BasicQueryParams.Params()
+	m["query"] = q.Query
+	m["list_id"] = q.ListID
+	return m
+}
+
+//// TABLE DEFINITION
+
+func tableMailchimpSearchMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "mailchimp_search_member",
+		Description: "Search for list members across all lists in the account.",
+		List: &plugin.ListConfig{
+			Hydrate: listSearchMembers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "query",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "list_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The MD5 hash of the lowercase version of the list member's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "email_address",
+				Description: "Email address for a subscriber.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The search query used to filter results. Query should be a valid email, or a string representing a contact's first or last name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "match_type",
+				Description: "Whether the member was returned as an exact match or by the full search. Possible values: 'exact' or 'full'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "list_id",
+				Description: "The list ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ListID"),
+			},
+			{
+				Name:        "full_name",
+				Description: "The contact's full name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Subscriber's current status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_type",
+				Description: "Type of email this member asked to get ('html' or 'text').",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_changed",
+				Description: "The date and time the member's info was last changed in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "member_rating",
+				Description: "Star rating for this member, between 1 and 5.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "timestamp_opt",
+				Description: "The date and time the subscriber confirmed their opt-in status in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "timestamp_signup",
+				Description: "The date and time the subscriber signed up for the list in ISO 8601 format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "unique_email_id",
+				Description: "An identifier for the address across all of Mailchimp.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UniqueEmailID"),
+			},
+			{
+				Name:        "vip",
+				Description: "VIP status for subscriber.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VIP"),
+			},
+
+			// JSON fields
+
+			{
+				Name:        "merge_fields",
+				Description: "A dictionary of merge fields where the keys are the merge tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "stats",
+				Description: "Open and click rates for this subscriber.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags",
+				Description: "The tags applied to this member.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: "The title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EmailAddress"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSearchMembers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	query := d.EqualsQualString("query")
+
+	// Query should not be empty
+	if query == "" {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := connectMailchimp(ctx, d)
+	if err != nil {
+		logger.Error("mailchimp_search_member.listSearchMembers", "connection_error", err)
+		return nil, err
+	}
+
+	params := searchMemberQueryParams{
+		Query: query,
+	}
+	if d.EqualsQuals["list_id"] != nil {
+		params.ListID = d.EqualsQualString("list_id")
+	}
+
+	result := new(searchMembersResponse)
+	err = client.Request("GET", "/search-members", &params, nil, result)
+	if err != nil {
+		logger.Error("mailchimp_search_member.listSearchMembers", "api_error", err)
+		return nil, err
+	}
+
+	for _, member := range result.ExactMatches.Members {
+		d.StreamListItem(ctx, &searchMember{listMember: member, MatchType: "exact"})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for _, member := range result.FullSearch.Members {
+		d.StreamListItem(ctx, &searchMember{listMember: member, MatchType: "full"})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
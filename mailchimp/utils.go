@@ -1,9 +1,14 @@
 package mailchimp
 
 import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"strings"
 
+	"github.com/hanzoai/gochimp3"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 func isNotFoundError(notFoundErrors []string) plugin.ErrorPredicate {
@@ -17,3 +22,37 @@ func isNotFoundError(notFoundErrors []string) plugin.ErrorPredicate {
 		return false
 	}
 }
+
+// getSubscriberHash returns the MD5 hash of the lowercase version of the email address,
+// which Mailchimp uses to identify a list member in the API paths.
+func getSubscriberHash(email string) string {
+	hash := md5.Sum([]byte(strings.ToLower(email)))
+	return hex.EncodeToString(hash[:])
+}
+
+// getMemberSubscriberHash returns the subscriber hash of the list member identified
+// by either the subscriber_hash or the email_address qual.
+func getMemberSubscriberHash(d *plugin.QueryData) string {
+	if d.EqualsQualString("subscriber_hash") != "" {
+		return d.EqualsQualString("subscriber_hash")
+	}
+	if d.EqualsQualString("email_address") != "" {
+		return getSubscriberHash(d.EqualsQualString("email_address"))
+	}
+	return ""
+}
+
+// campaignWasSent returns whether the campaign has been sent, and so has a report.
+func campaignWasSent(campaign *gochimp3.CampaignResponse) bool {
+	return campaign.SendTime != "" || campaign.EmailsSent > 0
+}
+
+//// TRANSFORM FUNCTIONS
+
+func subscriberHash(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	email, ok := d.Value.(string)
+	if !ok || email == "" {
+		return nil, nil
+	}
+	return getSubscriberHash(email), nil
+}